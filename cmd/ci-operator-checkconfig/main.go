@@ -6,15 +6,22 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/ghodss/yaml"
 
 	"github.com/openshift/ci-tools/pkg/api"
+	"github.com/openshift/ci-tools/pkg/config"
 )
 
 func main() {
 	var configDir string
+	var verifyOwners bool
+	var verifyPromotion bool
 	flag.StringVar(&configDir, "config-dir", "", "The directory containing configuration files.")
+	flag.BoolVar(&verifyOwners, "verify-owners", false, "Fail if a directory containing configuration files has no OWNERS file.")
+	flag.BoolVar(&verifyPromotion, "verify-promotion", false, "Fail if more than one configuration promotes to the same image stream tag.")
 	flag.Parse()
 
 	if configDir == "" {
@@ -39,12 +46,12 @@ func main() {
 				return fmt.Errorf("failed to load config from %s: %v", name, err)
 			}
 
-			var config api.ReleaseBuildConfiguration
-			if err := yaml.Unmarshal(data, &config); err != nil {
+			var configuration api.ReleaseBuildConfiguration
+			if err := yaml.Unmarshal(data, &configuration); err != nil {
 				return fmt.Errorf("invalid configuration from %s: %v\nvalue:%s", name, err, string(data))
 			}
 
-			if err := config.Validate(); err != nil {
+			if err := configuration.Validate(); err != nil {
 				return fmt.Errorf("invalid configuration from %s: %v", name, err)
 
 			}
@@ -55,4 +62,37 @@ func main() {
 		fmt.Printf("error loading configuration files: %v\n", err)
 		os.Exit(1)
 	}
+
+	if verifyOwners {
+		missing, err := config.MissingOwners(configDir)
+		if err != nil {
+			fmt.Printf("error checking OWNERS files: %v\n", err)
+			os.Exit(1)
+		}
+		for _, dir := range missing {
+			fmt.Printf("missing %s file in %s\n", config.OwnersFile, dir)
+		}
+		if len(missing) > 0 {
+			os.Exit(1)
+		}
+	}
+
+	if verifyPromotion {
+		conflicts, err := config.PromotionConflicts(configDir)
+		if err != nil {
+			fmt.Printf("error checking promotion conflicts: %v\n", err)
+			os.Exit(1)
+		}
+		var tags []string
+		for tag := range conflicts {
+			tags = append(tags, tag)
+		}
+		sort.Strings(tags)
+		for _, tag := range tags {
+			fmt.Printf("%s is promoted to by more than one configuration: %s\n", tag, strings.Join(conflicts[tag], ", "))
+		}
+		if len(conflicts) > 0 {
+			os.Exit(1)
+		}
+	}
 }
@@ -100,6 +100,8 @@ func validateTestStepConfiguration(fieldRoot string, input []TestStepConfigurati
 			validationErrors = append(validationErrors, fmt.Errorf("%s[%d].as: is required", fieldRoot, num))
 		} else if test.As == "images" {
 			validationErrors = append(validationErrors, fmt.Errorf("%s[%d].as: should not be called 'images' because it gets confused with '[images]' target", fieldRoot, num))
+		} else if test.As == ArtifactsResourcesName {
+			validationErrors = append(validationErrors, fmt.Errorf("%s[%d].as: should not be called '%s' because the name is reserved for the artifacts container resources", fieldRoot, num, ArtifactsResourcesName))
 		} else if ok := regexp.MustCompile("^[a-zA-Z0-9_.-]*$").MatchString(test.As); !ok {
 			validationErrors = append(validationErrors, fmt.Errorf("%s[%d].as: '%s' is not valid value, should be [a-zA-Z0-9_.-]", fieldRoot, num, test.As))
 		}
@@ -37,6 +37,17 @@ func TestValidateTests(t *testing.T) {
 			},
 			expectedValid: false,
 		},
+		{
+			id: `ReleaseBuildConfiguration{Tests: {As: "artifacts"}}`,
+			tests: []TestStepConfiguration{
+				{
+					As:                         "artifacts",
+					Commands:                   "commands",
+					ContainerTestConfiguration: &ContainerTestConfiguration{From: "ignored"},
+				},
+			},
+			expectedValid: false,
+		},
 		{
 			id: "No test type",
 			tests: []TestStepConfiguration{
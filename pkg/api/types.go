@@ -1,5 +1,12 @@
 package api
 
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
 // ReleaseBuildConfiguration describes how release
 // artifacts are built from a repository of source
 // code. The configuration is made up of two parts:
@@ -62,14 +69,70 @@ type ReleaseBuildConfiguration struct {
 
 	// Resources is a set of resource requests or limits over the
 	// input types. The special name '*' may be used to set default
-	// requests and limits.
+	// requests and limits. The special name 'artifacts' sets the
+	// requests and limits of the container holding test artifacts,
+	// for both container and template tests, and is not affected
+	// by '*'.
 	Resources ResourceConfiguration `json:"resources,omitempty"`
 }
 
+// PipelineImages returns the names of all the tags on the pipeline
+// ImageStream that the configuration produces: the build root and
+// source, any binary or RPM builds, base images imported into the
+// pipeline (with the intermediate tags of RPM-injected ones), the
+// outputs of image builds and the outputs of raw steps.
+func (config *ReleaseBuildConfiguration) PipelineImages() sets.String {
+	images := sets.NewString()
+	if config.InputConfiguration.BuildRootImage != nil {
+		images.Insert(string(PipelineImageStreamTagReferenceRoot), PipelineImageStreamTagReferenceSource)
+	}
+	if len(config.BinaryBuildCommands) > 0 {
+		images.Insert(PipelineImageStreamTagReferenceBinaries)
+	}
+	if len(config.TestBinaryBuildCommands) > 0 {
+		images.Insert(PipelineImageStreamTagReferenceTestBinaries)
+	}
+	if len(config.RpmBuildCommands) > 0 {
+		images.Insert(PipelineImageStreamTagReferenceRPMs)
+	}
+	for alias := range config.InputConfiguration.BaseImages {
+		images.Insert(alias)
+	}
+	for alias := range config.InputConfiguration.BaseRPMImages {
+		images.Insert(alias, fmt.Sprintf("%s-without-rpms", alias))
+	}
+	for _, image := range config.Images {
+		images.Insert(string(image.To))
+	}
+	for _, step := range config.RawSteps {
+		var to PipelineImageStreamTagReference
+		switch {
+		case step.InputImageTagStepConfiguration != nil:
+			to = step.InputImageTagStepConfiguration.To
+		case step.PipelineImageCacheStepConfiguration != nil:
+			to = step.PipelineImageCacheStepConfiguration.To
+		case step.SourceStepConfiguration != nil:
+			to = step.SourceStepConfiguration.To
+		case step.ProjectDirectoryImageBuildStepConfiguration != nil:
+			to = step.ProjectDirectoryImageBuildStepConfiguration.To
+		case step.RPMImageInjectionStepConfiguration != nil:
+			to = step.RPMImageInjectionStepConfiguration.To
+		}
+		if len(to) > 0 {
+			images.Insert(string(to))
+		}
+	}
+	return images
+}
+
 // ResourceConfiguration defines resource overrides for jobs run
 // by the operator.
 type ResourceConfiguration map[string]ResourceRequirements
 
+// ArtifactsResourcesName is the reserved name in the resource
+// configuration for the container that holds test artifacts.
+const ArtifactsResourcesName = "artifacts"
+
 func (c ResourceConfiguration) RequirementsForStep(name string) ResourceRequirements {
 	req := ResourceRequirements{
 		Requests: make(ResourceList),
@@ -553,5 +616,44 @@ const (
 
 	StableImageStream = "stable"
 
+	// ReleaseImageStream is the name of the ImageStream
+	// that holds release payload images, tagged by the
+	// name of the release.
+	ReleaseImageStream = "release"
+
 	ComponentFormatReplacement = "${component}"
 )
+
+// ReleaseStreamFor returns the name of the ImageStream holding the
+// component images of the named release: `stable` for the `latest`
+// release and `stable-<name>` for any other.
+func ReleaseStreamFor(release string) string {
+	if release == "latest" {
+		return StableImageStream
+	}
+	return fmt.Sprintf("%s-%s", StableImageStream, release)
+}
+
+// ParseReleaseImageReference determines whether a `stream:tag` image
+// reference points into a release. References into the `stable` stream
+// are to components of the `latest` release and references into a
+// `stable-<name>` stream are to components of the `<name>` release.
+// References into the `release` stream are to the payload image of the
+// release named by the tag, so no component tag is returned for them.
+func ParseReleaseImageReference(from string) (release, tag string, ok bool) {
+	parts := strings.Split(from, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	stream, tag := parts[0], parts[1]
+	prefix := fmt.Sprintf("%s-", StableImageStream)
+	switch {
+	case stream == StableImageStream:
+		return "latest", tag, true
+	case strings.HasPrefix(stream, prefix) && len(stream) > len(prefix):
+		return strings.TrimPrefix(stream, prefix), tag, true
+	case stream == ReleaseImageStream:
+		return tag, "", true
+	}
+	return "", "", false
+}
@@ -1,10 +1,13 @@
 package api
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
 	"github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 func TestOverlay(t *testing.T) {
@@ -134,3 +137,128 @@ func TestOverlay(t *testing.T) {
 		})
 	}
 }
+
+func TestPipelineImages(t *testing.T) {
+	var testCases = []struct {
+		name     string
+		config   ReleaseBuildConfiguration
+		expected sets.String
+	}{
+		{
+			name:     "empty configuration produces nothing",
+			config:   ReleaseBuildConfiguration{},
+			expected: sets.NewString(),
+		},
+		{
+			name: "build root produces root and src",
+			config: ReleaseBuildConfiguration{
+				InputConfiguration: InputConfiguration{
+					BuildRootImage: &BuildRootImageConfiguration{
+						ImageStreamTagReference: &ImageStreamTagReference{Tag: "golang"},
+					},
+				},
+			},
+			expected: sets.NewString("root", "src"),
+		},
+		{
+			name: "full configuration produces all images",
+			config: ReleaseBuildConfiguration{
+				InputConfiguration: InputConfiguration{
+					BuildRootImage: &BuildRootImageConfiguration{
+						ImageStreamTagReference: &ImageStreamTagReference{Tag: "golang"},
+					},
+					BaseImages: map[string]ImageStreamTagReference{
+						"base": {Name: "4.0", Namespace: "ocp", Tag: "base"},
+					},
+					BaseRPMImages: map[string]ImageStreamTagReference{
+						"base-rpms": {Name: "4.0", Namespace: "ocp", Tag: "base"},
+					},
+				},
+				BinaryBuildCommands:     "make",
+				TestBinaryBuildCommands: "make test-bin",
+				RpmBuildCommands:        "make rpms",
+				Images: []ProjectDirectoryImageBuildStepConfiguration{
+					{From: "base", To: "cli"},
+					{From: "cli", To: "tests"},
+				},
+			},
+			expected: sets.NewString("root", "src", "bin", "test-bin", "rpms", "base", "base-rpms", "base-rpms-without-rpms", "cli", "tests"),
+		},
+		{
+			name: "base RPM images produce the image and its intermediate tag",
+			config: ReleaseBuildConfiguration{
+				InputConfiguration: InputConfiguration{
+					BaseRPMImages: map[string]ImageStreamTagReference{
+						"base":  {Name: "4.0", Namespace: "ocp", Tag: "base"},
+						"other": {Name: "4.0", Namespace: "ocp", Tag: "other"},
+					},
+				},
+			},
+			expected: sets.NewString("base", "base-without-rpms", "other", "other-without-rpms"),
+		},
+		{
+			name: "raw steps produce their outputs",
+			config: ReleaseBuildConfiguration{
+				RawSteps: []StepConfiguration{
+					{InputImageTagStepConfiguration: &InputImageTagStepConfiguration{To: "input"}},
+					{PipelineImageCacheStepConfiguration: &PipelineImageCacheStepConfiguration{From: "src", To: "cache"}},
+					{SourceStepConfiguration: &SourceStepConfiguration{From: "root", To: "source"}},
+					{ProjectDirectoryImageBuildStepConfiguration: &ProjectDirectoryImageBuildStepConfiguration{From: "base", To: "built"}},
+					{RPMImageInjectionStepConfiguration: &RPMImageInjectionStepConfiguration{From: "built", To: "injected"}},
+					{OutputImageTagStepConfiguration: &OutputImageTagStepConfiguration{From: "built", To: ImageStreamTagReference{Name: "stable", Tag: "built"}}},
+				},
+			},
+			expected: sets.NewString("input", "cache", "source", "built", "injected"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if actual, expected := testCase.config.PipelineImages(), testCase.expected; !actual.Equal(expected) {
+				t.Errorf("%s: incorrect pipeline images: %s", testCase.name, diff.ObjectReflectDiff(expected.List(), actual.List()))
+			}
+		})
+	}
+}
+
+func TestParseReleaseImageReference(t *testing.T) {
+	var testCases = []struct {
+		from            string
+		expectedRelease string
+		expectedTag     string
+		expectedOk      bool
+	}{
+		{from: "stable-initial:installer", expectedRelease: "initial", expectedTag: "installer", expectedOk: true},
+		{from: "stable:installer", expectedRelease: "latest", expectedTag: "installer", expectedOk: true},
+		{from: "stable-custom:cli", expectedRelease: "custom", expectedTag: "cli", expectedOk: true},
+		{from: "release:latest", expectedRelease: "latest", expectedOk: true},
+		{from: "release:initial", expectedRelease: "initial", expectedOk: true},
+		{from: "pipeline:src"},
+		{from: "installer"},
+		{from: "stable:"},
+		{from: ":installer"},
+		{from: "stable-:installer"},
+		{from: "stable-initial:installer:extra"},
+		{from: "stabler:installer"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.from, func(t *testing.T) {
+			release, tag, ok := ParseReleaseImageReference(testCase.from)
+			if release != testCase.expectedRelease || tag != testCase.expectedTag || ok != testCase.expectedOk {
+				t.Errorf("%s: expected (%q, %q, %v), got (%q, %q, %v)", testCase.from, testCase.expectedRelease, testCase.expectedTag, testCase.expectedOk, release, tag, ok)
+			}
+		})
+	}
+}
+
+func TestReleaseStreamForRoundTrip(t *testing.T) {
+	for _, release := range []string{"latest", "initial", "custom"} {
+		t.Run(release, func(t *testing.T) {
+			parsed, tag, ok := ParseReleaseImageReference(fmt.Sprintf("%s:installer", ReleaseStreamFor(release)))
+			if !ok || parsed != release || tag != "installer" {
+				t.Errorf("%s: expected (%q, %q, true), got (%q, %q, %v)", release, release, "installer", parsed, tag, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	cioperatorapi "github.com/openshift/ci-tools/pkg/api"
+)
+
+// ClusterProfileUsage walks all CI Operator configuration files under
+// configDir and counts the tests that provision a cluster with each
+// cluster profile.
+func ClusterProfileUsage(configDir string) (map[cioperatorapi.ClusterProfile]int, error) {
+	usage := map[cioperatorapi.ClusterProfile]int{}
+	if err := OperateOnCIOperatorConfigDir(configDir, func(configuration *cioperatorapi.ReleaseBuildConfiguration, _ *Info) error {
+		for _, test := range configuration.Tests {
+			if profile := clusterProfileForTest(test); profile != "" {
+				usage[profile]++
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+// clusterProfileForTest returns the cluster profile used by the
+// test, or an empty profile if the test does not provision a cluster
+func clusterProfileForTest(test cioperatorapi.TestStepConfiguration) cioperatorapi.ClusterProfile {
+	switch {
+	case test.OpenshiftAnsibleClusterTestConfiguration != nil:
+		return test.OpenshiftAnsibleClusterTestConfiguration.ClusterProfile
+	case test.OpenshiftAnsibleSrcClusterTestConfiguration != nil:
+		return test.OpenshiftAnsibleSrcClusterTestConfiguration.ClusterProfile
+	case test.OpenshiftAnsibleCustomClusterTestConfiguration != nil:
+		return test.OpenshiftAnsibleCustomClusterTestConfiguration.ClusterProfile
+	case test.OpenshiftAnsible40ClusterTestConfiguration != nil:
+		return test.OpenshiftAnsible40ClusterTestConfiguration.ClusterProfile
+	case test.OpenshiftAnsibleUpgradeClusterTestConfiguration != nil:
+		return test.OpenshiftAnsibleUpgradeClusterTestConfiguration.ClusterProfile
+	case test.OpenshiftInstallerClusterTestConfiguration != nil:
+		return test.OpenshiftInstallerClusterTestConfiguration.ClusterProfile
+	case test.OpenshiftInstallerSrcClusterTestConfiguration != nil:
+		return test.OpenshiftInstallerSrcClusterTestConfiguration.ClusterProfile
+	case test.OpenshiftInstallerUPIClusterTestConfiguration != nil:
+		return test.OpenshiftInstallerUPIClusterTestConfiguration.ClusterProfile
+	case test.OpenshiftInstallerConsoleClusterTestConfiguration != nil:
+		return test.OpenshiftInstallerConsoleClusterTestConfiguration.ClusterProfile
+	}
+	return ""
+}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/diff"
+
+	cioperatorapi "github.com/openshift/ci-tools/pkg/api"
+)
+
+const clusterTestsConfig = `tag_specification:
+  name: '4.0'
+  namespace: ocp
+build_root:
+  image_stream_tag:
+    name: release
+    namespace: openshift
+    tag: golang-1.10
+tests:
+- as: unit
+  commands: make test-unit
+  container:
+    from: src
+- as: e2e-aws
+  commands: make test-e2e
+  openshift_installer:
+    cluster_profile: aws
+- as: e2e-gcp
+  commands: make test-e2e
+  openshift_installer_src:
+    cluster_profile: gcp
+resources:
+  '*':
+    requests:
+      cpu: 10m
+`
+
+const awsTestConfig = `tag_specification:
+  name: '4.0'
+  namespace: ocp
+build_root:
+  image_stream_tag:
+    name: release
+    namespace: openshift
+    tag: golang-1.10
+tests:
+- as: e2e-aws
+  commands: make test-e2e
+  openshift_installer:
+    cluster_profile: aws
+resources:
+  '*':
+    requests:
+      cpu: 10m
+`
+
+func TestClusterProfileUsage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"org/repo/org-repo-master.yaml":   clusterTestsConfig,
+		"org/other/org-other-master.yaml": awsTestConfig,
+		"org/unit/org-unit-master.yaml":   minimalConfig,
+	}
+	writeConfigTree(t, dir, files)
+	usage, err := ClusterProfileUsage(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[cioperatorapi.ClusterProfile]int{
+		cioperatorapi.ClusterProfileAWS: 2,
+		cioperatorapi.ClusterProfileGCP: 1,
+	}
+	if !reflect.DeepEqual(expected, usage) {
+		t.Errorf("incorrect cluster profile usage: %s", diff.ObjectReflectDiff(expected, usage))
+	}
+}
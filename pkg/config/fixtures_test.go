@@ -0,0 +1,41 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const minimalConfig = `tag_specification:
+  name: '4.0'
+  namespace: ocp
+build_root:
+  image_stream_tag:
+    name: release
+    namespace: openshift
+    tag: golang-1.10
+tests:
+- as: unit
+  commands: make test-unit
+  container:
+    from: src
+resources:
+  '*':
+    requests:
+      cpu: 10m
+`
+
+// writeConfigTree writes files, keyed by their path relative to dir, into dir
+func writeConfigTree(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0775); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0664); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
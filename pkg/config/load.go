@@ -1,10 +1,10 @@
 package config
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
-	"path"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -16,12 +16,20 @@ import (
 	cioperatorapi "github.com/openshift/ci-tools/pkg/api"
 )
 
-func readCiOperatorConfig(configFilePath string) (*cioperatorapi.ReleaseBuildConfiguration, error) {
+func readCiOperatorConfig(configFilePath string) (*cioperatorapi.ReleaseBuildConfiguration, []byte, error) {
 	data, err := ioutil.ReadFile(configFilePath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read ci-operator config (%v)", err)
+		return nil, nil, fmt.Errorf("failed to read ci-operator config (%v)", err)
 	}
 
+	configSpec, err := parseCiOperatorConfig(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return configSpec, data, nil
+}
+
+func parseCiOperatorConfig(data []byte) (*cioperatorapi.ReleaseBuildConfiguration, error) {
 	var configSpec *cioperatorapi.ReleaseBuildConfiguration
 	if err := yaml.Unmarshal(data, &configSpec); err != nil {
 		return nil, fmt.Errorf("failed to load ci-operator config (%v)", err)
@@ -54,6 +62,12 @@ func (i *Info) Basename() string {
 	return fmt.Sprintf("%s.yaml", basename)
 }
 
+// RelativePath returns the path, relative to the root of the configuration
+// directory, at which this file is stored by convention
+func (i *Info) RelativePath() string {
+	return filepath.Join(i.Org, i.Repo, i.Basename())
+}
+
 // ConfigMapName returns the configmap in which we expect this file to be uploaded
 func (i *Info) ConfigMapName() string {
 	return fmt.Sprintf("ci-operator-%s-configs", promotion.FlavorForBranch(i.Branch))
@@ -107,7 +121,16 @@ func isConfigFile(path string, info os.FileInfo) bool {
 // OperateOnCIOperatorConfig runs the callback on the parsed data from
 // the CI Operator configuration file provided
 func OperateOnCIOperatorConfig(path string, callback func(*cioperatorapi.ReleaseBuildConfiguration, *Info) error) error {
-	jobConfig, err := readCiOperatorConfig(path)
+	return OperateOnCIOperatorConfigWithRaw(path, func(configuration *cioperatorapi.ReleaseBuildConfiguration, info *Info, _ []byte, _ string) error {
+		return callback(configuration, info)
+	})
+}
+
+// OperateOnCIOperatorConfigWithRaw runs the callback on the parsed data from
+// the CI Operator configuration file provided, additionally passing the raw
+// content of the file and its absolute path
+func OperateOnCIOperatorConfigWithRaw(path string, callback func(*cioperatorapi.ReleaseBuildConfiguration, *Info, []byte, string) error) error {
+	jobConfig, raw, err := readCiOperatorConfig(path)
 	if err != nil {
 		logrus.WithField("source-file", path).WithError(err).Error("Failed to load CI Operator configuration")
 		return err
@@ -118,7 +141,13 @@ func OperateOnCIOperatorConfig(path string, callback func(*cioperatorapi.Release
 		logrus.WithField("source-file", path).WithError(err).Error("Failed to load CI Operator configuration")
 		return err
 	}
-	if err = callback(jobConfig, info); err != nil {
+
+	absolutePath, err := filepath.Abs(path)
+	if err != nil {
+		logrus.WithField("source-file", path).WithError(err).Error("Failed to determine absolute path for CI Operator configuration")
+		return err
+	}
+	if err = callback(jobConfig, info, raw, absolutePath); err != nil {
 		logrus.WithField("source-file", path).WithError(err).Error("Failed to execute callback")
 		return err
 	}
@@ -128,13 +157,42 @@ func OperateOnCIOperatorConfig(path string, callback func(*cioperatorapi.Release
 // OperateOnCIOperatorConfigDir runs the callback on all CI Operator
 // configuration files found while walking the directory provided
 func OperateOnCIOperatorConfigDir(configDir string, callback func(*cioperatorapi.ReleaseBuildConfiguration, *Info) error) error {
+	return OperateOnCIOperatorConfigDirWithRaw(configDir, func(configuration *cioperatorapi.ReleaseBuildConfiguration, info *Info, _ []byte, _ string) error {
+		return callback(configuration, info)
+	})
+}
+
+// OperateOnCIOperatorConfigDirWithRaw runs the callback on all CI Operator
+// configuration files found while walking the directory provided, passing
+// the raw content and absolute path of each file along with the parsed data
+func OperateOnCIOperatorConfigDirWithRaw(configDir string, callback func(*cioperatorapi.ReleaseBuildConfiguration, *Info, []byte, string) error) error {
+	return OperateOnCIOperatorConfigDirWithRawAndContext(context.Background(), configDir, callback)
+}
+
+// OperateOnCIOperatorConfigDirWithContext runs the callback on all CI Operator
+// configuration files found while walking the directory provided, stopping
+// the walk as soon as the context is cancelled
+func OperateOnCIOperatorConfigDirWithContext(ctx context.Context, configDir string, callback func(*cioperatorapi.ReleaseBuildConfiguration, *Info) error) error {
+	return OperateOnCIOperatorConfigDirWithRawAndContext(ctx, configDir, func(configuration *cioperatorapi.ReleaseBuildConfiguration, info *Info, _ []byte, _ string) error {
+		return callback(configuration, info)
+	})
+}
+
+// OperateOnCIOperatorConfigDirWithRawAndContext runs the callback on all CI
+// Operator configuration files found while walking the directory provided,
+// passing the raw content and absolute path of each file along with the
+// parsed data and stopping the walk as soon as the context is cancelled
+func OperateOnCIOperatorConfigDirWithRawAndContext(ctx context.Context, configDir string, callback func(*cioperatorapi.ReleaseBuildConfiguration, *Info, []byte, string) error) error {
 	return filepath.Walk(configDir, func(path string, info os.FileInfo, err error) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		if err != nil {
 			logrus.WithField("source-file", path).WithError(err).Error("Failed to walk CI Operator configuration dir")
 			return err
 		}
 		if isConfigFile(path, info) {
-			if err := OperateOnCIOperatorConfig(path, callback); err != nil {
+			if err := OperateOnCIOperatorConfigWithRaw(path, callback); err != nil {
 				return err
 			}
 		}
@@ -142,6 +200,26 @@ func OperateOnCIOperatorConfigDir(configDir string, callback func(*cioperatorapi
 	})
 }
 
+// OperateOnCIOperatorConfigDirs runs the callback on all CI Operator
+// configuration files found while walking each of the directories provided,
+// in order. It is an error for more than one file across the directories to
+// hold the configuration for the same org, repo, branch and variant.
+func OperateOnCIOperatorConfigDirs(configDirs []string, callback func(*cioperatorapi.ReleaseBuildConfiguration, *Info) error) error {
+	seen := map[string]string{}
+	for _, configDir := range configDirs {
+		if err := OperateOnCIOperatorConfigDir(configDir, func(configuration *cioperatorapi.ReleaseBuildConfiguration, info *Info) error {
+			if previous, duplicate := seen[info.RelativePath()]; duplicate {
+				return fmt.Errorf("found duplicate configuration for %s: %s and %s", info.RelativePath(), previous, info.Filename)
+			}
+			seen[info.RelativePath()] = info.Filename
+			return callback(configuration, info)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func LoggerForInfo(info Info) *logrus.Entry {
 	return logrus.WithFields(logrus.Fields{
 		"org":         info.Org,
@@ -167,7 +245,7 @@ func (i *DataWithInfo) CommitTo(dir string) error {
 		i.Logger().WithError(err).Error("failed to marshal output CI Operator configuration")
 		return err
 	}
-	outputFile := path.Join(dir, i.Info.Org, i.Info.Repo, i.Info.Basename())
+	outputFile := filepath.Join(dir, i.Info.RelativePath())
 	if err := ioutil.WriteFile(outputFile, raw, 0664); err != nil {
 		i.Logger().WithError(err).Error("failed to write new CI Operator configuration")
 		return err
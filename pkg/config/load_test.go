@@ -1,9 +1,15 @@
 package config
 
 import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
+	cioperatorapi "github.com/openshift/ci-tools/pkg/api"
+
 	"k8s.io/apimachinery/pkg/util/diff"
 )
 
@@ -115,6 +121,46 @@ func TestInfo_Basename(t *testing.T) {
 	}
 }
 
+func TestInfo_RelativePath(t *testing.T) {
+	testCases := []struct {
+		name     string
+		info     *Info
+		expected string
+	}{
+		{
+			name:     "simple info",
+			info:     &Info{Org: "org", Repo: "repo", Branch: "branch"},
+			expected: "org/repo/org-repo-branch.yaml",
+		},
+		{
+			name:     "info with variant",
+			info:     &Info{Org: "org", Repo: "repo", Branch: "branch", Variant: "variant"},
+			expected: "org/repo/org-repo-branch__variant.yaml",
+		},
+		{
+			name:     "info with dashed and dotted names",
+			info:     &Info{Org: "open-shift", Repo: "repo.git", Branch: "release-4.1", Variant: "the-variant"},
+			expected: "open-shift/repo.git/open-shift-repo.git-release-4.1__the-variant.yaml",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			relativePath := testCase.info.RelativePath()
+			if actual, expected := relativePath, testCase.expected; actual != expected {
+				t.Errorf("%s: didn't get correct relative path: %v", testCase.name, diff.StringDiff(actual, expected))
+			}
+			parsed, err := InfoFromPath(filepath.Join("prefix", relativePath))
+			if err != nil {
+				t.Fatalf("%s: failed to parse the relative path: %v", testCase.name, err)
+			}
+			parsed.Filename = ""
+			if actual, expected := parsed, testCase.info; !reflect.DeepEqual(actual, expected) {
+				t.Errorf("%s: relative path did not round-trip: %v", testCase.name, diff.ObjectReflectDiff(actual, expected))
+			}
+		})
+	}
+}
+
 func TestInfo_ConfigMapName(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -201,3 +247,125 @@ func TestInfo_ConfigMapName(t *testing.T) {
 		})
 	}
 }
+
+func TestOperateOnCIOperatorConfigDirWithRaw(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeConfigTree(t, dir, map[string]string{"org/repo/org-repo-master.yaml": minimalConfig})
+	path := filepath.Join(dir, "org", "repo", "org-repo-master.yaml")
+
+	var calls int
+	if err := OperateOnCIOperatorConfigDirWithRaw(dir, func(_ *cioperatorapi.ReleaseBuildConfiguration, info *Info, raw []byte, absolutePath string) error {
+		calls++
+		if actual, expected := string(raw), minimalConfig; actual != expected {
+			t.Errorf("raw content did not match the file: %s", diff.StringDiff(actual, expected))
+		}
+		if !filepath.IsAbs(absolutePath) {
+			t.Errorf("expected an absolute path, got %q", absolutePath)
+		}
+		if actual, expected := absolutePath, path; actual != expected {
+			t.Errorf("expected path %q, got %q", expected, actual)
+		}
+		if actual, expected := info.Branch, "master"; actual != expected {
+			t.Errorf("expected branch %q, got %q", expected, actual)
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected callback to be called once, got %d", calls)
+	}
+}
+
+func TestOperateOnCIOperatorConfigDirs(t *testing.T) {
+	var testCases = []struct {
+		name          string
+		files         []string
+		expected      []string
+		expectedError bool
+	}{
+		{
+			name:     "configurations across directories are all processed in order",
+			files:    []string{"first/org/repo/org-repo-master.yaml", "second/org/repo/org-repo-release-4.1.yaml", "second/org/other/org-other-master.yaml"},
+			expected: []string{"org-repo-master.yaml", "org-other-master.yaml", "org-repo-release-4.1.yaml"},
+		},
+		{
+			name:     "variants of the same branch are not duplicates",
+			files:    []string{"first/org/repo/org-repo-master.yaml", "second/org/repo/org-repo-master__variant.yaml"},
+			expected: []string{"org-repo-master.yaml", "org-repo-master__variant.yaml"},
+		},
+		{
+			name:     "different org and repo pairs with the same basename are not duplicates",
+			files:    []string{"first/a-b/c/a-b-c-master.yaml", "second/a/b-c/a-b-c-master.yaml"},
+			expected: []string{"a-b-c-master.yaml", "a-b-c-master.yaml"},
+		},
+		{
+			name:          "the same branch in two directories is a duplicate",
+			files:         []string{"first/org/repo/org-repo-master.yaml", "second/org/repo/org-repo-master.yaml"},
+			expected:      []string{"org-repo-master.yaml"},
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			files := map[string]string{}
+			for _, file := range testCase.files {
+				files[file] = minimalConfig
+			}
+			writeConfigTree(t, dir, files)
+
+			var processed []string
+			err = OperateOnCIOperatorConfigDirs([]string{filepath.Join(dir, "first"), filepath.Join(dir, "second")}, func(_ *cioperatorapi.ReleaseBuildConfiguration, info *Info) error {
+				processed = append(processed, info.Basename())
+				return nil
+			})
+			if err == nil && testCase.expectedError {
+				t.Errorf("%s: expected an error, but got none", testCase.name)
+			}
+			if err != nil && !testCase.expectedError {
+				t.Errorf("%s: expected no error, but got one: %v", testCase.name, err)
+			}
+			if actual, expected := processed, testCase.expected; !reflect.DeepEqual(actual, expected) {
+				t.Errorf("%s: incorrect configurations processed: %v", testCase.name, diff.ObjectReflectDiff(actual, expected))
+			}
+		})
+	}
+}
+
+func TestOperateOnCIOperatorConfigDirWithContext(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeConfigTree(t, dir, map[string]string{
+		"org/repo/org-repo-master.yaml":      minimalConfig,
+		"org/repo/org-repo-release-4.1.yaml": minimalConfig,
+		"org/repo/org-repo-release-4.2.yaml": minimalConfig,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var calls int
+	err = OperateOnCIOperatorConfigDirWithContext(ctx, dir, func(_ *cioperatorapi.ReleaseBuildConfiguration, _ *Info) error {
+		calls++
+		cancel()
+		return nil
+	})
+	if err != context.Canceled {
+		t.Errorf("expected the walk to return %v, got %v", context.Canceled, err)
+	}
+	if calls != 1 {
+		t.Errorf("expected the walk to stop after the first file, but the callback was called %d times", calls)
+	}
+}
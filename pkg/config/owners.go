@@ -0,0 +1,37 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	cioperatorapi "github.com/openshift/ci-tools/pkg/api"
+)
+
+// OwnersFile is the name of the file that declares the owners of a directory
+const OwnersFile = "OWNERS"
+
+// MissingOwners walks all CI Operator configuration files under configDir and
+// returns the directories, relative to configDir, that contain a configuration
+// file but no OWNERS file. All findings are returned, sorted by directory.
+func MissingOwners(configDir string) ([]string, error) {
+	missing := sets.NewString()
+	if err := OperateOnCIOperatorConfigDir(configDir, func(_ *cioperatorapi.ReleaseBuildConfiguration, info *Info) error {
+		dir := filepath.Dir(info.Filename)
+		if _, err := os.Stat(filepath.Join(dir, OwnersFile)); err != nil {
+			if !os.IsNotExist(err) {
+				return err
+			}
+			relative, err := filepath.Rel(configDir, dir)
+			if err != nil {
+				return err
+			}
+			missing.Insert(relative)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return missing.List(), nil
+}
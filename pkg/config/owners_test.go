@@ -0,0 +1,34 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/diff"
+)
+
+func TestMissingOwners(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"org/repo/org-repo-master.yaml":            minimalConfig,
+		"org/repo/OWNERS":                          "approvers:\n- someone\n",
+		"org/other/org-other-master.yaml":          minimalConfig,
+		"org/other/org-other-master__variant.yaml": minimalConfig,
+		"another/repo/another-repo-master.yaml":    minimalConfig,
+		"another/repo/OWNERS":                      "approvers:\n- someone\n",
+	}
+	writeConfigTree(t, dir, files)
+	missing, err := MissingOwners(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{"org/other"}; !reflect.DeepEqual(expected, missing) {
+		t.Errorf("incorrect directories reported: %s", diff.ObjectReflectDiff(expected, missing))
+	}
+}
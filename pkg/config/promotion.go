@@ -0,0 +1,34 @@
+package config
+
+import (
+	"sort"
+
+	"github.com/openshift/ci-tools/pkg/promotion"
+
+	cioperatorapi "github.com/openshift/ci-tools/pkg/api"
+)
+
+// PromotionConflicts walks all CI Operator configuration files under
+// configDir and returns every image stream tag that is promoted to by
+// more than one configuration, mapped to the paths, relative to configDir,
+// of the configurations promoting it.
+func PromotionConflicts(configDir string) (map[string][]string, error) {
+	promoters := map[string][]string{}
+	if err := OperateOnCIOperatorConfigDir(configDir, func(configuration *cioperatorapi.ReleaseBuildConfiguration, info *Info) error {
+		for _, tag := range promotion.PromotedTags(configuration) {
+			promoters[tag] = append(promoters[tag], info.RelativePath())
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	conflicts := map[string][]string{}
+	for tag, configurations := range promoters {
+		if len(configurations) > 1 {
+			sort.Strings(configurations)
+			conflicts[tag] = configurations
+		}
+	}
+	return conflicts, nil
+}
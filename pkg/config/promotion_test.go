@@ -0,0 +1,57 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/diff"
+)
+
+const promotingConfig = `promotion:
+  name: '4.1'
+  namespace: ocp
+build_root:
+  image_stream_tag:
+    name: release
+    namespace: openshift
+    tag: golang-1.10
+images:
+- from: base
+  to: cli
+- from: base
+  to: %s
+resources:
+  '*':
+    requests:
+      cpu: 10m
+`
+
+func TestPromotionConflicts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"org/repo/org-repo-master.yaml":   fmt.Sprintf(promotingConfig, "repo"),
+		"org/other/org-other-master.yaml": fmt.Sprintf(promotingConfig, "other"),
+		"org/unit/org-unit-master.yaml":   minimalConfig,
+		"a-b/c/a-b-c-master.yaml":         fmt.Sprintf(promotingConfig, "c"),
+		"a/b-c/a-b-c-master.yaml":         fmt.Sprintf(promotingConfig, "c"),
+	}
+	writeConfigTree(t, dir, files)
+	conflicts, err := PromotionConflicts(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string][]string{
+		"ocp/4.1:cli": {"a-b/c/a-b-c-master.yaml", "a/b-c/a-b-c-master.yaml", "org/other/org-other-master.yaml", "org/repo/org-repo-master.yaml"},
+		"ocp/4.1:c":   {"a-b/c/a-b-c-master.yaml", "a/b-c/a-b-c-master.yaml"},
+	}
+	if !reflect.DeepEqual(expected, conflicts) {
+		t.Errorf("incorrect conflicts: %s", diff.ObjectReflectDiff(expected, conflicts))
+	}
+}
@@ -156,7 +156,7 @@ func FromConfig(
 
 		} else if rawStep.TestStepConfiguration != nil && rawStep.TestStepConfiguration.OpenshiftInstallerClusterTestConfiguration != nil && rawStep.TestStepConfiguration.OpenshiftInstallerClusterTestConfiguration.Upgrade {
 			var err error
-			step, err = clusterinstall.E2ETestStep(*rawStep.TestStepConfiguration.OpenshiftInstallerClusterTestConfiguration, *rawStep.TestStepConfiguration, params, podClient, templateClient, secretGetter, artifactDir, jobSpec)
+			step, err = clusterinstall.E2ETestStep(*rawStep.TestStepConfiguration.OpenshiftInstallerClusterTestConfiguration, *rawStep.TestStepConfiguration, params, config.Resources, podClient, templateClient, secretGetter, artifactDir, jobSpec)
 			if err != nil {
 				return nil, nil, fmt.Errorf("unable to create end to end test step: %v", err)
 			}
@@ -175,7 +175,7 @@ func FromConfig(
 	}
 
 	for _, template := range templates {
-		step := steps.TemplateExecutionStep(template, params, podClient, templateClient, artifactDir, jobSpec)
+		step := steps.TemplateExecutionStep(template, params, config.Resources, podClient, templateClient, artifactDir, jobSpec)
 		buildSteps = append(buildSteps, step)
 	}
 
@@ -57,6 +57,37 @@ func extractPromotionName(configSpec *cioperatorapi.ReleaseBuildConfiguration) s
 	return ""
 }
 
+// PromotedTags returns the image stream tags, as `namespace/name:tag`, that
+// a configuration promotes to. Optional images are not promoted unless
+// explicitly targeted, so they are not considered.
+func PromotedTags(configSpec *cioperatorapi.ReleaseBuildConfiguration) []string {
+	if configSpec.PromotionConfiguration == nil || isDisabled(configSpec) {
+		return nil
+	}
+	promotion := configSpec.PromotionConfiguration
+
+	names := sets.NewString()
+	for _, image := range configSpec.Images {
+		if !image.Optional {
+			names.Insert(string(image.To))
+		}
+	}
+	names.Delete(promotion.ExcludedImages...)
+	for name := range promotion.AdditionalImages {
+		names.Insert(name)
+	}
+
+	var tags []string
+	for _, name := range names.List() {
+		if len(promotion.Name) > 0 {
+			tags = append(tags, fmt.Sprintf("%s/%s:%s", promotion.Namespace, promotion.Name, name))
+		} else {
+			tags = append(tags, fmt.Sprintf("%s/%s%s:%s", promotion.Namespace, promotion.NamePrefix, name, promotion.Tag))
+		}
+	}
+	return tags
+}
+
 // IsBumpable determines if the dev branch should be bumped or not
 func IsBumpable(branch, currentRelease string) bool {
 	return branch != fmt.Sprintf("openshift-%s", currentRelease)
@@ -70,6 +70,61 @@ func TestPromotesOfficialImages(t *testing.T) {
 	}
 }
 
+func TestPromotedTags(t *testing.T) {
+	var testCases = []struct {
+		name       string
+		configSpec *cioperatorapi.ReleaseBuildConfiguration
+		expected   []string
+	}{
+		{
+			name:       "config without promotion promotes nothing",
+			configSpec: &cioperatorapi.ReleaseBuildConfiguration{Images: []cioperatorapi.ProjectDirectoryImageBuildStepConfiguration{{To: "cli"}}},
+			expected:   nil,
+		},
+		{
+			name: "disabled promotion promotes nothing",
+			configSpec: &cioperatorapi.ReleaseBuildConfiguration{
+				PromotionConfiguration: &cioperatorapi.PromotionConfiguration{Namespace: "ocp", Name: "4.1", Disabled: true},
+				Images:                 []cioperatorapi.ProjectDirectoryImageBuildStepConfiguration{{To: "cli"}},
+			},
+			expected: nil,
+		},
+		{
+			name: "promotion to a named stream promotes tags on that stream",
+			configSpec: &cioperatorapi.ReleaseBuildConfiguration{
+				PromotionConfiguration: &cioperatorapi.PromotionConfiguration{
+					Namespace:        "ocp",
+					Name:             "4.1",
+					ExcludedImages:   []string{"excluded"},
+					AdditionalImages: map[string]string{"artifacts": "src"},
+				},
+				Images: []cioperatorapi.ProjectDirectoryImageBuildStepConfiguration{
+					{To: "cli"},
+					{To: "excluded"},
+					{To: "optional", Optional: true},
+				},
+			},
+			expected: []string{"ocp/4.1:artifacts", "ocp/4.1:cli"},
+		},
+		{
+			name: "promotion with a tag promotes to a stream per image",
+			configSpec: &cioperatorapi.ReleaseBuildConfiguration{
+				PromotionConfiguration: &cioperatorapi.PromotionConfiguration{Namespace: "openshift", Tag: "latest", NamePrefix: "origin-"},
+				Images:                 []cioperatorapi.ProjectDirectoryImageBuildStepConfiguration{{To: "cli"}, {To: "tests"}},
+			},
+			expected: []string{"openshift/origin-cli:latest", "openshift/origin-tests:latest"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if actual, expected := PromotedTags(testCase.configSpec), testCase.expected; !reflect.DeepEqual(actual, expected) {
+				t.Errorf("%s: incorrect promoted tags: %v", testCase.name, diff.ObjectReflectDiff(actual, expected))
+			}
+		})
+	}
+}
+
 func TestDetermineReleaseBranches(t *testing.T) {
 	var testCases = []struct {
 		name                                         string
@@ -19,6 +19,7 @@ import (
 
 	coreapi "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -31,6 +32,7 @@ import (
 	buildapi "github.com/openshift/api/build/v1"
 	templateapi "github.com/openshift/api/template/v1"
 
+	"github.com/openshift/ci-tools/pkg/api"
 	"github.com/openshift/ci-tools/pkg/junit"
 )
 
@@ -288,8 +290,10 @@ func removeFile(podClient PodClient, ns, name, containerName string, paths []str
 	return nil
 }
 
-func addArtifactsContainer(pod *coreapi.Pod, artifactDir string) {
-	pod.Spec.Containers = append(pod.Spec.Containers, artifactsContainer())
+func addArtifactsContainer(pod *coreapi.Pod, resources coreapi.ResourceRequirements) {
+	container := artifactsContainer()
+	container.Resources = resources
+	pod.Spec.Containers = append(pod.Spec.Containers, container)
 	pod.Spec.Volumes = append(pod.Spec.Volumes, coreapi.Volume{
 		Name: "artifacts",
 		VolumeSource: coreapi.VolumeSource{
@@ -298,10 +302,48 @@ func addArtifactsContainer(pod *coreapi.Pod, artifactDir string) {
 	})
 }
 
+// artifactsContainerResources are requested for the container that holds
+// artifacts until they are extracted so that it is not the first to be
+// evicted from a busy node, losing the artifacts
+func artifactsContainerResources() coreapi.ResourceRequirements {
+	return coreapi.ResourceRequirements{
+		Requests: coreapi.ResourceList{
+			coreapi.ResourceCPU:    resource.MustParse("10m"),
+			coreapi.ResourceMemory: resource.MustParse("10Mi"),
+		},
+	}
+}
+
+// artifactsContainerResourcesFor returns the resources for the artifacts
+// container, overriding the defaults with any requests or limits set for
+// the reserved name in the resource configuration
+func artifactsContainerResourcesFor(config api.ResourceConfiguration) (coreapi.ResourceRequirements, error) {
+	resources := artifactsContainerResources()
+	requirements, ok := config[api.ArtifactsResourcesName]
+	if !ok {
+		return resources, nil
+	}
+	overrides, err := resourcesFor(requirements)
+	if err != nil {
+		return coreapi.ResourceRequirements{}, err
+	}
+	for name, quantity := range overrides.Requests {
+		resources.Requests[name] = quantity
+	}
+	for name, quantity := range overrides.Limits {
+		if resources.Limits == nil {
+			resources.Limits = make(coreapi.ResourceList)
+		}
+		resources.Limits[name] = quantity
+	}
+	return resources, nil
+}
+
 func artifactsContainer() coreapi.Container {
 	return coreapi.Container{
-		Name:  "artifacts",
-		Image: "busybox",
+		Name:      "artifacts",
+		Image:     "busybox",
+		Resources: artifactsContainerResources(),
 		VolumeMounts: []coreapi.VolumeMount{
 			{Name: "artifacts", MountPath: "/tmp/artifacts"},
 		},
@@ -559,7 +601,7 @@ func containerHasVolumeName(container coreapi.Container, name string) bool {
 	return true
 }
 
-func addArtifactsToTemplate(template *templateapi.Template) {
+func addArtifactsToTemplate(template *templateapi.Template, resources coreapi.ResourceRequirements) {
 	for i := range template.Objects {
 		t := &template.Objects[i]
 		var pod map[string]interface{}
@@ -577,15 +619,17 @@ func addArtifactsToTemplate(template *templateapi.Template) {
 		if len(names) == 0 {
 			continue
 		}
-		data, err := json.Marshal(artifactsContainer())
+		container := artifactsContainer()
+		container.Resources = resources
+		data, err := json.Marshal(container)
 		if err != nil {
 			panic(err)
 		}
-		var container map[string]interface{}
-		if err := json.Unmarshal(data, &container); err != nil {
+		var containerJSON map[string]interface{}
+		if err := json.Unmarshal(data, &containerJSON); err != nil {
 			panic(err)
 		}
-		containers := append(jsonArray(pod, "spec", "containers"), container)
+		containers := append(jsonArray(pod, "spec", "containers"), containerJSON)
 		jsonMap(pod, "spec")["containers"] = containers
 		data, err = json.Marshal(pod)
 		if err != nil {
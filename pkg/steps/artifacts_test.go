@@ -1,13 +1,20 @@
 package steps
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 	"time"
 
+	templateapi "github.com/openshift/api/template/v1"
+
+	"github.com/openshift/ci-tools/pkg/api"
 	"github.com/openshift/ci-tools/pkg/junit"
 	coreapi "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/diff"
 )
 
@@ -447,3 +454,113 @@ func TestTestCaseNotifier_SubTests(t *testing.T) {
 		})
 	}
 }
+
+func TestAddArtifactsContainer(t *testing.T) {
+	pod := &coreapi.Pod{Spec: coreapi.PodSpec{Containers: []coreapi.Container{{Name: "test"}}}}
+	resources := coreapi.ResourceRequirements{Requests: coreapi.ResourceList{coreapi.ResourceCPU: resource.MustParse("50m")}}
+	addArtifactsContainer(pod, resources)
+	if len(pod.Spec.Containers) != 2 {
+		t.Fatalf("expected the artifacts container to be added, got %d containers", len(pod.Spec.Containers))
+	}
+	container := pod.Spec.Containers[1]
+	if container.Name != "artifacts" {
+		t.Fatalf("expected the artifacts container, got %q", container.Name)
+	}
+	if !equality.Semantic.DeepEqual(resources, container.Resources) {
+		t.Errorf("incorrect artifacts container resources: %s", diff.ObjectReflectDiff(resources, container.Resources))
+	}
+}
+
+func TestAddArtifactsToTemplate(t *testing.T) {
+	template := &templateapi.Template{
+		Objects: []runtime.RawExtension{{Raw: []byte(`{
+	"kind": "Pod",
+	"apiVersion": "v1",
+	"spec": {
+		"volumes": [{"name": "artifacts"}],
+		"containers": [{"name": "test", "volumeMounts": [{"name": "artifacts", "mountPath": "/tmp/artifacts"}]}]
+	}
+}`)}},
+	}
+	resources := coreapi.ResourceRequirements{Requests: coreapi.ResourceList{coreapi.ResourceCPU: resource.MustParse("50m")}}
+	addArtifactsToTemplate(template, resources)
+	var pod coreapi.Pod
+	if err := json.Unmarshal(template.Objects[0].Raw, &pod); err != nil {
+		t.Fatal(err)
+	}
+	if len(pod.Spec.Containers) != 2 {
+		t.Fatalf("expected the artifacts container to be added, got %d containers", len(pod.Spec.Containers))
+	}
+	container := pod.Spec.Containers[1]
+	if container.Name != "artifacts" {
+		t.Fatalf("expected the artifacts container, got %q", container.Name)
+	}
+	if !equality.Semantic.DeepEqual(resources, container.Resources) {
+		t.Errorf("incorrect artifacts container resources: %s", diff.ObjectReflectDiff(resources, container.Resources))
+	}
+}
+
+func TestArtifactsContainerResourcesFor(t *testing.T) {
+	var testCases = []struct {
+		name          string
+		config        api.ResourceConfiguration
+		expected      coreapi.ResourceRequirements
+		expectedError bool
+	}{
+		{
+			name:     "no configuration uses the defaults",
+			config:   api.ResourceConfiguration{},
+			expected: artifactsContainerResources(),
+		},
+		{
+			name: "wildcard configuration does not apply to the artifacts container",
+			config: api.ResourceConfiguration{
+				"*": {Requests: api.ResourceList{"cpu": "1"}},
+			},
+			expected: artifactsContainerResources(),
+		},
+		{
+			name: "artifacts configuration overrides the defaults",
+			config: api.ResourceConfiguration{
+				"artifacts": {
+					Requests: api.ResourceList{"cpu": "100m"},
+					Limits:   api.ResourceList{"memory": "1Gi"},
+				},
+			},
+			expected: coreapi.ResourceRequirements{
+				Requests: coreapi.ResourceList{
+					coreapi.ResourceCPU:    resource.MustParse("100m"),
+					coreapi.ResourceMemory: resource.MustParse("10Mi"),
+				},
+				Limits: coreapi.ResourceList{
+					coreapi.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			},
+		},
+		{
+			name: "invalid artifacts configuration is an error",
+			config: api.ResourceConfiguration{
+				"artifacts": {Requests: api.ResourceList{"cpu": "a lot"}},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resources, err := artifactsContainerResourcesFor(testCase.config)
+			if err == nil && testCase.expectedError {
+				t.Fatalf("%s: expected an error, but got none", testCase.name)
+			}
+			if err != nil && !testCase.expectedError {
+				t.Fatalf("%s: expected no error, but got one: %v", testCase.name, err)
+			}
+			if testCase.expectedError {
+				return
+			}
+			if !equality.Semantic.DeepEqual(testCase.expected, resources) {
+				t.Errorf("%s: incorrect resources: %s", testCase.name, diff.ObjectReflectDiff(testCase.expected, resources))
+			}
+		})
+	}
+}
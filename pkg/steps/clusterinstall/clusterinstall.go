@@ -37,6 +37,7 @@ func E2ETestStep(
 	config api.OpenshiftInstallerClusterTestConfiguration,
 	testConfig api.TestStepConfiguration,
 	params api.Parameters,
+	resources api.ResourceConfiguration,
 	podClient steps.PodClient,
 	templateClient steps.TemplateClient,
 	secretClient coreclientset.SecretsGetter,
@@ -76,7 +77,7 @@ func E2ETestStep(
 		// ensure the installer image points to the initial state
 		name = "IMAGE_INSTALLER"
 		if !params.HasInput(name) {
-			overrides[name] = "stable-initial:installer"
+			overrides[name] = fmt.Sprintf("%s:installer", api.ReleaseStreamFor("initial"))
 		}
 		template.Parameters = append(template.Parameters, templateapi.Parameter{
 			Required: true,
@@ -94,7 +95,7 @@ func E2ETestStep(
 		params = api.NewOverrideParameters(params, overrides)
 	}
 
-	step := steps.TemplateExecutionStep(template, params, podClient, templateClient, artifactDir, jobSpec)
+	step := steps.TemplateExecutionStep(template, params, resources, podClient, templateClient, artifactDir, jobSpec)
 	subTests, ok := step.(nestedSubTests)
 	if !ok {
 		return nil, fmt.Errorf("unexpected %T", step)
@@ -82,7 +82,11 @@ func (s *podStep) Run(ctx context.Context, dry bool) error {
 			Name:      "artifacts",
 			MountPath: s.config.ArtifactDir,
 		})
-		addArtifactsContainer(pod, s.config.ArtifactDir)
+		artifactsResources, err := artifactsContainerResourcesFor(s.resources)
+		if err != nil {
+			return fmt.Errorf("unable to calculate artifacts container resources for %s: %s", s.config.As, err)
+		}
+		addArtifactsContainer(pod, artifactsResources)
 		artifacts.CollectFromPod(pod.Name, true, []string{s.name}, nil)
 		notifier = artifacts
 	}
@@ -107,14 +107,14 @@ func (s *assembleReleaseStep) Run(ctx context.Context, dry bool) error {
 	// ensure the image stream exists
 	release, err := s.imageClient.ImageStreams(s.jobSpec.Namespace).Create(&imageapi.ImageStream{
 		ObjectMeta: meta.ObjectMeta{
-			Name: "release",
+			Name: api.ReleaseImageStream,
 		},
 	})
 	if err != nil {
 		if !errors.IsAlreadyExists(err) {
 			return err
 		}
-		release, err = s.imageClient.ImageStreams(s.jobSpec.Namespace).Get("release", meta.GetOptions{})
+		release, err = s.imageClient.ImageStreams(s.jobSpec.Namespace).Get(api.ReleaseImageStream, meta.GetOptions{})
 		if err != nil {
 			return err
 		}
@@ -510,10 +510,7 @@ func (s *assembleReleaseStep) tag() string {
 }
 
 func (s *assembleReleaseStep) streamName() string {
-	if s.latest {
-		return api.StableImageStream
-	}
-	return fmt.Sprintf("%s-initial", api.StableImageStream)
+	return api.ReleaseStreamFor(s.tag())
 }
 
 func (s *assembleReleaseStep) envVar() string {
@@ -524,7 +521,7 @@ func (s *assembleReleaseStep) Provides() (api.ParameterMap, api.StepLink) {
 	tag := s.tag()
 	return api.ParameterMap{
 		s.envVar(): func() (string, error) {
-			is, err := s.imageClient.ImageStreams(s.jobSpec.Namespace).Get("release", meta.GetOptions{})
+			is, err := s.imageClient.ImageStreams(s.jobSpec.Namespace).Get(api.ReleaseImageStream, meta.GetOptions{})
 			if err != nil {
 				return "", fmt.Errorf("could not retrieve output imagestream: %v", err)
 			}
@@ -534,7 +531,7 @@ func (s *assembleReleaseStep) Provides() (api.ParameterMap, api.StepLink) {
 			} else if len(is.Status.DockerImageRepository) > 0 {
 				registry = is.Status.DockerImageRepository
 			} else {
-				return "", fmt.Errorf("image stream %s has no accessible image registry value", "release")
+				return "", fmt.Errorf("image stream %s has no accessible image registry value", api.ReleaseImageStream)
 			}
 			ref, image := findStatusTag(is, tag)
 			if len(image) > 0 {
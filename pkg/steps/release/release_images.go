@@ -206,7 +206,7 @@ func (s *releaseImagesTagStep) Run(ctx context.Context, dry bool) error {
 	}
 
 	initialIS := newIS.DeepCopy()
-	initialIS.Name = fmt.Sprintf("%s-initial", api.StableImageStream)
+	initialIS.Name = api.ReleaseStreamFor("initial")
 
 	is, err = s.dstClient.ImageStreams(s.jobSpec.Namespace).Create(newIS)
 	if err != nil && !errors.IsAlreadyExists(err) {
@@ -34,6 +34,7 @@ import (
 type templateExecutionStep struct {
 	template       *templateapi.Template
 	params         api.Parameters
+	resources      api.ResourceConfiguration
 	templateClient TemplateClient
 	podClient      PodClient
 	artifactDir    string
@@ -85,7 +86,11 @@ func (s *templateExecutionStep) Run(ctx context.Context, dry bool) error {
 	}
 
 	if len(s.artifactDir) > 0 {
-		addArtifactsToTemplate(s.template)
+		artifactsResources, err := artifactsContainerResourcesFor(s.resources)
+		if err != nil {
+			return fmt.Errorf("unable to calculate artifacts container resources for %s: %s", s.template.Name, err)
+		}
+		addArtifactsToTemplate(s.template, artifactsResources)
 	}
 
 	if dry {
@@ -235,10 +240,11 @@ func (s *templateExecutionStep) Description() string {
 	return fmt.Sprintf("Run template %s", s.template.Name)
 }
 
-func TemplateExecutionStep(template *templateapi.Template, params api.Parameters, podClient PodClient, templateClient TemplateClient, artifactDir string, jobSpec *api.JobSpec) api.Step {
+func TemplateExecutionStep(template *templateapi.Template, params api.Parameters, resources api.ResourceConfiguration, podClient PodClient, templateClient TemplateClient, artifactDir string, jobSpec *api.JobSpec) api.Step {
 	return &templateExecutionStep{
 		template:       template,
 		params:         params,
+		resources:      resources,
 		podClient:      podClient,
 		templateClient: templateClient,
 		artifactDir:    artifactDir,